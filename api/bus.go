@@ -0,0 +1,9 @@
+package api
+
+// Bus sends WebDriver wire-protocol requests for a session and decodes the
+// response into result. The only implementation in this module is
+// mocks.Bus, used in tests; a real session needs a transport supplied by
+// the caller.
+type Bus interface {
+	Send(method, endpoint string, body, result interface{}) error
+}
@@ -0,0 +1,68 @@
+package api
+
+import "fmt"
+
+// Element is a handle to a single remote DOM element, addressable by its
+// WebDriver element ID on a particular session.
+type Element struct {
+	ID      string
+	Session *Session
+}
+
+func (e *Element) Click() error {
+	return e.Session.Bus.Send("POST", fmt.Sprintf("element/%s/click", e.ID), nil, nil)
+}
+
+func (e *Element) Clear() error {
+	return e.Session.Bus.Send("POST", fmt.Sprintf("element/%s/clear", e.ID), nil, nil)
+}
+
+func (e *Element) Value(text string) error {
+	request := map[string]interface{}{"value": splitChars(text)}
+	return e.Session.Bus.Send("POST", fmt.Sprintf("element/%s/value", e.ID), request, nil)
+}
+
+func (e *Element) Submit() error {
+	return e.Session.Bus.Send("POST", fmt.Sprintf("element/%s/submit", e.ID), nil, nil)
+}
+
+func (e *Element) GetAttribute(attribute string) (string, error) {
+	var value string
+	err := e.Session.Bus.Send("GET", fmt.Sprintf("element/%s/attribute/%s", e.ID, attribute), nil, &value)
+	return value, err
+}
+
+func (e *Element) GetName() (string, error) {
+	var name string
+	err := e.Session.Bus.Send("GET", fmt.Sprintf("element/%s/name", e.ID), nil, &name)
+	return name, err
+}
+
+func (e *Element) IsSelected() (bool, error) {
+	var selected bool
+	err := e.Session.Bus.Send("GET", fmt.Sprintf("element/%s/selected", e.ID), nil, &selected)
+	return selected, err
+}
+
+func (e *Element) GetElements(selector Selector) ([]*Element, error) {
+	var results []struct {
+		ID string `json:"ELEMENT"`
+	}
+	if err := e.Session.Bus.Send("POST", fmt.Sprintf("element/%s/elements", e.ID), selector, &results); err != nil {
+		return nil, err
+	}
+
+	elements := make([]*Element, len(results))
+	for i, result := range results {
+		elements[i] = &Element{ID: result.ID, Session: e.Session}
+	}
+	return elements, nil
+}
+
+func splitChars(text string) []string {
+	chars := make([]string, 0, len(text))
+	for _, r := range text {
+		chars = append(chars, string(r))
+	}
+	return chars
+}
@@ -0,0 +1,14 @@
+package api
+
+// Selector identifies child elements using the WebDriver "using"/"value"
+// locator strategy pair (e.g. {Using: "xpath", Value: "./option"}).
+type Selector struct {
+	Using string
+	Value string
+}
+
+// Point is an x, y offset relative to an element, used by Session.MoveTo.
+type Point struct {
+	X int
+	Y int
+}
@@ -0,0 +1,48 @@
+package api
+
+import "sync"
+
+// Session is a single WebDriver session. It owns the Bus used to talk to
+// the remote end and exposes the handful of session-scoped (rather than
+// element-scoped) commands such as moving the mouse and clicking at its
+// current position. Session embeds a Mutex so that callers fanning
+// element-scoped actions out across a worker pool can still serialize
+// these session-scoped commands (see MultiSelection.Parallel).
+type Session struct {
+	Bus Bus
+
+	sync.Mutex
+}
+
+func (s *Session) MoveTo(element *Element, point *Point) error {
+	request := map[string]interface{}{}
+	if element != nil {
+		request["element"] = element.ID
+	}
+	if point != nil {
+		request["xoffset"] = point.X
+		request["yoffset"] = point.Y
+	}
+	return s.Bus.Send("POST", "moveto", request, nil)
+}
+
+func (s *Session) DoubleClick() error {
+	return s.Bus.Send("POST", "doubleclick", nil, nil)
+}
+
+// GetElements locates the elements at the root of the page (rather than
+// beneath a particular element; see Element.GetElements) matching selector.
+func (s *Session) GetElements(selector Selector) ([]*Element, error) {
+	var results []struct {
+		ID string `json:"ELEMENT"`
+	}
+	if err := s.Bus.Send("POST", "elements", selector, &results); err != nil {
+		return nil, err
+	}
+
+	elements := make([]*Element, len(results))
+	for i, result := range results {
+		elements[i] = &Element{ID: result.ID, Session: s}
+	}
+	return elements, nil
+}
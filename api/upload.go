@@ -0,0 +1,54 @@
+package api
+
+import (
+	"archive/zip"
+	"bytes"
+	"encoding/base64"
+	"fmt"
+	"os"
+	"path/filepath"
+)
+
+// UploadFile zips the file at localPath and ships it to the session's
+// "/session/:id/file" endpoint, returning the path the file was written
+// to on the machine the session is running on. WebDriver elements only
+// accept file paths that exist on that machine, which is not necessarily
+// the one running this code (e.g. a remote Selenium Grid node), so
+// selections upload through this method rather than using localPath
+// directly.
+func (s *Session) UploadFile(localPath string) (string, error) {
+	zipped, err := zipFile(localPath)
+	if err != nil {
+		return "", fmt.Errorf("failed to zip file %q: %s", localPath, err)
+	}
+
+	request := map[string]interface{}{"file": base64.StdEncoding.EncodeToString(zipped)}
+	var serverPath string
+	if err := s.Bus.Send("POST", "file", request, &serverPath); err != nil {
+		return "", err
+	}
+	return serverPath, nil
+}
+
+func zipFile(localPath string) ([]byte, error) {
+	contents, err := os.ReadFile(localPath)
+	if err != nil {
+		return nil, err
+	}
+
+	var buffer bytes.Buffer
+	writer := zip.NewWriter(&buffer)
+
+	entry, err := writer.Create(filepath.Base(localPath))
+	if err != nil {
+		return nil, err
+	}
+	if _, err := entry.Write(contents); err != nil {
+		return nil, err
+	}
+	if err := writer.Close(); err != nil {
+		return nil, err
+	}
+
+	return buffer.Bytes(), nil
+}
@@ -0,0 +1,126 @@
+package agouti_test
+
+import (
+	"errors"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/sclevine/agouti"
+	"github.com/sclevine/agouti/internal/element"
+	"github.com/sclevine/agouti/internal/mocks"
+)
+
+var _ = Describe("Typed element selections", func() {
+	var (
+		selection         *Selection
+		elementRepository *mocks.ElementRepository
+		mockElement       *mocks.Element
+	)
+
+	BeforeEach(func() {
+		mockElement = &mocks.Element{}
+		elementRepository = &mocks.ElementRepository{}
+		elementRepository.GetAtLeastOneCall.ReturnElements = []element.Element{mockElement}
+		selection = NewTestSelection(&mocks.Session{}, elementRepository, "#selector")
+	})
+
+	Describe("#AsInput", func() {
+		It("should read the 'value' attribute", func() {
+			mockElement.GetAttributeCall.ReturnValue = "hello"
+			input := selection.AsInput()
+			Expect(input.Value()).To(Equal("hello"))
+			Expect(mockElement.GetAttributeCall.Attribute).To(Equal("value"))
+		})
+
+		It("should report whether the input is required", func() {
+			mockElement.GetAttributeCall.ReturnValue = "true"
+			Expect(selection.AsInput().Required()).To(BeTrue())
+		})
+
+		It("should fill the element when SetValue is called", func() {
+			Expect(selection.AsInput().SetValue("hello")).To(Succeed())
+			Expect(mockElement.ValueCall.Text).To(Equal("hello"))
+		})
+
+		Context("when retrieving the attribute fails", func() {
+			It("should return an error", func() {
+				mockElement.GetAttributeCall.Err = errors.New("some error")
+				_, err := selection.AsInput().Value()
+				Expect(err).To(MatchError("some error"))
+			})
+		})
+
+		It("should parse the 'tabindex' attribute as an int", func() {
+			mockElement.GetAttributeCall.ReturnValue = "4"
+			Expect(selection.AsInput().TabIndex()).To(Equal(4))
+		})
+
+		Context("when the 'tabindex' attribute is not a number", func() {
+			It("should return an error", func() {
+				mockElement.GetAttributeCall.ReturnValue = "four"
+				_, err := selection.AsInput().TabIndex()
+				Expect(err).To(HaveOccurred())
+			})
+		})
+	})
+
+	Describe("#AsLink", func() {
+		It("should parse the 'href' attribute as a URL", func() {
+			mockElement.GetAttributeCall.ReturnValue = "http://example.com/path"
+			href, err := selection.AsLink().Href()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(href.Host).To(Equal("example.com"))
+		})
+	})
+
+	Describe("#AsForm", func() {
+		It("should report the 'method' attribute when it is a recognized value", func() {
+			mockElement.GetAttributeCall.ReturnValue = "post"
+			Expect(selection.AsForm().Method()).To(Equal("post"))
+		})
+
+		Context("when the 'method' attribute is not get or post", func() {
+			It("should return an error", func() {
+				mockElement.GetAttributeCall.ReturnValue = "put"
+				_, err := selection.AsForm().Method()
+				Expect(err).To(MatchError(`unrecognized value "put" for Method`))
+			})
+		})
+	})
+
+	Describe("#AsElement", func() {
+		It("should return the typed wrapper matching the element's tag name", func() {
+			mockElement.GetNameCall.ReturnName = "a"
+			Expect(selection.AsElement()).To(BeAssignableToTypeOf(&LinkSelection{}))
+		})
+
+		Context("when the tag has multiple entries distinguished by an attribute", func() {
+			It("should match the entry whose attribute value matches", func() {
+				mockElement.GetNameCall.ReturnName = "input"
+				mockElement.GetAttributeCall.ReturnValue = "checkbox"
+				Expect(selection.AsElement()).To(BeAssignableToTypeOf(&CheckboxSelection{}))
+			})
+
+			It("should fall through to the next matching entry otherwise", func() {
+				mockElement.GetNameCall.ReturnName = "input"
+				mockElement.GetAttributeCall.ReturnValue = "text"
+				Expect(selection.AsElement()).To(BeAssignableToTypeOf(&InputSelection{}))
+			})
+		})
+
+		Context("when nothing in tags.yaml matches the tag name", func() {
+			It("should return the selection unchanged", func() {
+				mockElement.GetNameCall.ReturnName = "div"
+				Expect(selection.AsElement()).To(BeIdenticalTo(selection))
+			})
+		})
+
+		Context("when retrieving the tag name fails", func() {
+			It("should return an error", func() {
+				mockElement.GetNameCall.Err = errors.New("some error")
+				_, err := selection.AsElement()
+				Expect(err).To(MatchError("some error"))
+			})
+		})
+	})
+})
@@ -0,0 +1,100 @@
+package agouti
+
+import (
+	"fmt"
+
+	"github.com/sclevine/agouti/api"
+)
+
+var allOptionsSelector = api.Selector{Using: "xpath", Value: "./option"}
+
+func optionsByValueSelector(value string) api.Selector {
+	return api.Selector{Using: "xpath", Value: fmt.Sprintf(`./option[@value="%s"]`, value)}
+}
+
+// Checked reports whether the checkbox is currently checked.
+func (s *CheckboxSelection) Checked() (bool, error) {
+	elements, err := s.elements.GetAtLeastOne()
+	if err != nil {
+		return false, fmt.Errorf("failed to select elements from selection '%s': %s", s, err)
+	}
+	return elements[0].IsSelected()
+}
+
+// Options returns the "value" attribute of every <option> in the select
+// element.
+func (s *SelectSelection) Options() ([]string, error) {
+	elements, err := s.elements.GetAtLeastOne()
+	if err != nil {
+		return nil, fmt.Errorf("failed to select elements from selection '%s': %s", s, err)
+	}
+
+	options, err := elements[0].GetElements(allOptionsSelector)
+	if err != nil {
+		return nil, fmt.Errorf("failed to retrieve options for selection '%s': %s", s, err)
+	}
+
+	texts := make([]string, len(options))
+	for i, option := range options {
+		name, err := option.GetAttribute("value")
+		if err != nil {
+			return nil, fmt.Errorf("failed to retrieve option value for selection '%s': %s", s, err)
+		}
+		texts[i] = name
+	}
+	return texts, nil
+}
+
+// SelectedOptions returns the values of the currently selected <option>
+// elements.
+func (s *SelectSelection) SelectedOptions() ([]string, error) {
+	elements, err := s.elements.GetAtLeastOne()
+	if err != nil {
+		return nil, fmt.Errorf("failed to select elements from selection '%s': %s", s, err)
+	}
+
+	options, err := elements[0].GetElements(allOptionsSelector)
+	if err != nil {
+		return nil, fmt.Errorf("failed to retrieve options for selection '%s': %s", s, err)
+	}
+
+	var selected []string
+	for _, option := range options {
+		isSelected, err := option.IsSelected()
+		if err != nil {
+			return nil, fmt.Errorf("failed to retrieve option state for selection '%s': %s", s, err)
+		}
+		if !isSelected {
+			continue
+		}
+		value, err := option.GetAttribute("value")
+		if err != nil {
+			return nil, fmt.Errorf("failed to retrieve option value for selection '%s': %s", s, err)
+		}
+		selected = append(selected, value)
+	}
+	return selected, nil
+}
+
+// SelectByValue clicks the <option> with the given "value" attribute.
+func (s *SelectSelection) SelectByValue(value string) error {
+	elements, err := s.elements.GetAtLeastOne()
+	if err != nil {
+		return fmt.Errorf("failed to select elements from selection '%s': %s", s, err)
+	}
+
+	options, err := elements[0].GetElements(optionsByValueSelector(value))
+	if err != nil {
+		return fmt.Errorf("failed to select specified option for selection '%s': %s", s, err)
+	}
+	if len(options) == 0 {
+		return fmt.Errorf("no options with value %q found for selection '%s'", value, s)
+	}
+
+	for _, option := range options {
+		if err := option.Click(); err != nil {
+			return fmt.Errorf("failed to click on option with value %q for selection '%s': %s", value, s, err)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,148 @@
+package agouti_test
+
+import (
+	"errors"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/sclevine/agouti"
+	"github.com/sclevine/agouti/api"
+	"github.com/sclevine/agouti/internal/element"
+	"github.com/sclevine/agouti/internal/mocks"
+)
+
+var _ = Describe("Typed element actions", func() {
+	var (
+		selection         *Selection
+		elementRepository *mocks.ElementRepository
+		mockElement       *mocks.Element
+	)
+
+	BeforeEach(func() {
+		mockElement = &mocks.Element{}
+		elementRepository = &mocks.ElementRepository{}
+		elementRepository.GetAtLeastOneCall.ReturnElements = []element.Element{mockElement}
+		selection = NewTestSelection(&mocks.Session{}, elementRepository, "#selector")
+	})
+
+	Describe("#Checked", func() {
+		It("should report whether the checkbox is selected", func() {
+			mockElement.IsSelectedCall.ReturnSelected = true
+			Expect(selection.AsCheckbox().Checked()).To(BeTrue())
+		})
+
+		Context("when zero elements are returned", func() {
+			It("should return an error", func() {
+				elementRepository.GetAtLeastOneCall.Err = errors.New("some error")
+				_, err := selection.AsCheckbox().Checked()
+				Expect(err).To(MatchError("failed to select elements from selection 'CSS: #selector': some error"))
+			})
+		})
+	})
+
+	Describe("#Options", func() {
+		BeforeEach(func() {
+			mockElement.GetElementsCall.ReturnElements = []*api.Element{
+				{ID: "one", Session: &api.Session{Bus: &mocks.Bus{}}},
+			}
+		})
+
+		It("should request every option of the select element", func() {
+			Expect(selection.AsSelect().Options()).To(Equal([]string{""}))
+			Expect(mockElement.GetElementsCall.Selector.Using).To(Equal("xpath"))
+			Expect(mockElement.GetElementsCall.Selector.Value).To(Equal("./option"))
+		})
+
+		Context("when zero elements are returned", func() {
+			It("should return an error", func() {
+				elementRepository.GetAtLeastOneCall.Err = errors.New("some error")
+				_, err := selection.AsSelect().Options()
+				Expect(err).To(MatchError("failed to select elements from selection 'CSS: #selector': some error"))
+			})
+		})
+
+		Context("when retrieving the options fails", func() {
+			It("should return an error", func() {
+				mockElement.GetElementsCall.Err = errors.New("some error")
+				_, err := selection.AsSelect().Options()
+				Expect(err).To(MatchError("failed to retrieve options for selection 'CSS: #selector': some error"))
+			})
+		})
+	})
+
+	Describe("#SelectedOptions", func() {
+		It("should return only the options that are selected", func() {
+			bus := &mocks.Bus{}
+			mockElement.GetElementsCall.ReturnElements = []*api.Element{
+				{ID: "one", Session: &api.Session{Bus: bus}},
+			}
+			Expect(selection.AsSelect().SelectedOptions()).To(BeEmpty())
+		})
+
+		Context("when zero elements are returned", func() {
+			It("should return an error", func() {
+				elementRepository.GetAtLeastOneCall.Err = errors.New("some error")
+				_, err := selection.AsSelect().SelectedOptions()
+				Expect(err).To(MatchError("failed to select elements from selection 'CSS: #selector': some error"))
+			})
+		})
+
+		Context("when retrieving the options fails", func() {
+			It("should return an error", func() {
+				mockElement.GetElementsCall.Err = errors.New("some error")
+				_, err := selection.AsSelect().SelectedOptions()
+				Expect(err).To(MatchError("failed to retrieve options for selection 'CSS: #selector': some error"))
+			})
+		})
+	})
+
+	Describe("#SelectByValue", func() {
+		var optionBus *mocks.Bus
+
+		BeforeEach(func() {
+			optionBus = &mocks.Bus{}
+			mockElement.GetElementsCall.ReturnElements = []*api.Element{
+				{ID: "one", Session: &api.Session{Bus: optionBus}},
+			}
+		})
+
+		It("should click the option with the matching value", func() {
+			Expect(selection.AsSelect().SelectByValue("some-value")).To(Succeed())
+			Expect(mockElement.GetElementsCall.Selector.Using).To(Equal("xpath"))
+			Expect(mockElement.GetElementsCall.Selector.Value).To(Equal(`./option[@value="some-value"]`))
+			Expect(optionBus.SendCall.Endpoint).To(Equal("element/one/click"))
+		})
+
+		Context("when zero elements are returned", func() {
+			It("should return an error", func() {
+				elementRepository.GetAtLeastOneCall.Err = errors.New("some error")
+				err := selection.AsSelect().SelectByValue("some-value")
+				Expect(err).To(MatchError("failed to select elements from selection 'CSS: #selector': some error"))
+			})
+		})
+
+		Context("when retrieving the option fails", func() {
+			It("should return an error", func() {
+				mockElement.GetElementsCall.Err = errors.New("some error")
+				err := selection.AsSelect().SelectByValue("some-value")
+				Expect(err).To(MatchError("failed to select specified option for selection 'CSS: #selector': some error"))
+			})
+		})
+
+		Context("when no option has the given value", func() {
+			It("should return an error", func() {
+				mockElement.GetElementsCall.ReturnElements = []*api.Element{}
+				err := selection.AsSelect().SelectByValue("some-value")
+				Expect(err).To(MatchError(`no options with value "some-value" found for selection 'CSS: #selector'`))
+			})
+		})
+
+		Context("when clicking the option fails", func() {
+			It("should return an error", func() {
+				optionBus.SendCall.Err = errors.New("some error")
+				err := selection.AsSelect().SelectByValue("some-value")
+				Expect(err).To(MatchError(`failed to click on option with value "some-value" for selection 'CSS: #selector': some error`))
+			})
+		})
+	})
+})
@@ -0,0 +1,27 @@
+package agouti
+
+import "github.com/sclevine/agouti/internal/element"
+
+// NewTestMultiSelection builds a MultiSelection directly from its
+// collaborators, bypassing Page.Find, so selection action tests can run
+// against mocks without a real WebDriver session.
+func NewTestMultiSelection(session session, elements element.Repository, selector string) *MultiSelection {
+	return &MultiSelection{
+		selectorString: "CSS: " + selector,
+		session:        session,
+		elements:       elements,
+	}
+}
+
+// NewTestSelection builds a Selection directly from its collaborators,
+// bypassing Page.Find, so single-element action tests can run against
+// mocks without a real WebDriver session.
+func NewTestSelection(session session, elements element.Repository, selector string) *Selection {
+	return &Selection{NewTestMultiSelection(session, elements, selector)}
+}
+
+// NewTestPage builds a Page directly from its session, so Page.Find tests
+// can run against a mock session without a real WebDriver session.
+func NewTestPage(session session) *Page {
+	return &Page{session: session}
+}
@@ -0,0 +1,24 @@
+// Package element defines the element-scoped operations that selections
+// act on, independent of how a particular element was located.
+package element
+
+import "github.com/sclevine/agouti/api"
+
+// Element is a single located DOM element.
+type Element interface {
+	Click() error
+	Clear() error
+	Value(text string) error
+	Submit() error
+	GetAttribute(attribute string) (string, error)
+	GetName() (string, error)
+	IsSelected() (bool, error)
+	GetElements(selector api.Selector) ([]*api.Element, error)
+}
+
+// Repository locates the elements that back a selection. Implementations
+// apply the selection's chain of selectors against the page and fail if
+// no elements are found.
+type Repository interface {
+	GetAtLeastOne() ([]Element, error)
+}
@@ -0,0 +1,234 @@
+// Command elementgen reads a table of HTML tags and their typed attributes
+// from a YAML file and emits the typed Selection wrappers (element_gen.go)
+// (Selection.AsInput and friends) plus the tag-to-type dispatcher used by
+// Selection.AsElement.
+//
+// Run it via `go generate ./...` from the module root; the generated file
+// is checked in, so this only needs to run again when tags.yaml changes.
+package main
+
+import (
+	"bytes"
+	"flag"
+	"fmt"
+	"go/format"
+	"os"
+	"strings"
+	"text/template"
+
+	"gopkg.in/yaml.v3"
+)
+
+type attribute struct {
+	Name      string   `yaml:"name"`
+	Attribute string   `yaml:"attribute"`
+	Kind      string   `yaml:"kind"`
+	Settable  bool     `yaml:"settable"`
+	Values    []string `yaml:"values"` // allowed values, for kind: enum
+}
+
+type tag struct {
+	Tag            string      `yaml:"tag"`
+	As             string      `yaml:"as"`
+	Type           string      `yaml:"type"`
+	MatchAttribute string      `yaml:"matchAttribute"`
+	MatchValue     string      `yaml:"matchValue"`
+	Attributes     []attribute `yaml:"attributes"`
+}
+
+type table struct {
+	Tags []tag `yaml:"tags"`
+}
+
+// templateData is what sourceTemplate renders from. Imports is computed
+// from the kinds actually used in Tags, so the generated file never ends
+// up with an unused (or missing) import.
+type templateData struct {
+	Tags    []tag
+	Imports []string
+}
+
+func imports(parsed table) []string {
+	need := map[string]bool{}
+	for _, t := range parsed.Tags {
+		for _, a := range t.Attributes {
+			switch a.Kind {
+			case "url":
+				need["net/url"] = true
+			case "int":
+				need["strconv"] = true
+			case "enum":
+				need["fmt"] = true
+			}
+		}
+	}
+
+	var list []string
+	for _, path := range []string{"fmt", "net/url", "strconv"} {
+		if need[path] {
+			list = append(list, path)
+		}
+	}
+	return list
+}
+
+func main() {
+	input := flag.String("input", "internal/elementgen/tags.yaml", "path to the tag table")
+	output := flag.String("output", "element_gen.go", "path to write the generated source to")
+	flag.Parse()
+
+	raw, err := os.ReadFile(*input)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, "elementgen:", err)
+		os.Exit(1)
+	}
+
+	var parsed table
+	if err := yaml.Unmarshal(raw, &parsed); err != nil {
+		fmt.Fprintln(os.Stderr, "elementgen:", err)
+		os.Exit(1)
+	}
+
+	source, err := render(parsed)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, "elementgen:", err)
+		os.Exit(1)
+	}
+
+	if err := os.WriteFile(*output, source, 0644); err != nil {
+		fmt.Fprintln(os.Stderr, "elementgen:", err)
+		os.Exit(1)
+	}
+}
+
+func render(parsed table) ([]byte, error) {
+	data := templateData{Tags: parsed.Tags, Imports: imports(parsed)}
+
+	var buffer bytes.Buffer
+	if err := sourceTemplate.Execute(&buffer, data); err != nil {
+		return nil, fmt.Errorf("executing template: %s", err)
+	}
+
+	formatted, err := format.Source(buffer.Bytes())
+	if err != nil {
+		return nil, fmt.Errorf("formatting generated source: %s", err)
+	}
+
+	return formatted, nil
+}
+
+var templateFuncs = template.FuncMap{
+	"kindType":  kindType,
+	"kindZero":  kindZero,
+	"kindParse": kindParse,
+}
+
+var sourceTemplate = template.Must(template.New("element_gen").Funcs(templateFuncs).Parse(`// Code generated by internal/elementgen from tags.yaml. DO NOT EDIT.
+
+package agouti
+{{if .Imports}}
+import (
+{{range .Imports}}	"{{.}}"
+{{end}})
+{{end}}
+{{range $tag := .Tags}}
+// {{$tag.Type}} is a typed wrapper around a Selection matched against a
+// "{{$tag.Tag}}" element{{if $tag.MatchAttribute}} with {{$tag.MatchAttribute}}="{{$tag.MatchValue}}"{{end}}.
+type {{$tag.Type}} struct {
+	*Selection
+}
+
+// {{$tag.As}} narrows the selection to a {{$tag.Type}}, giving access to its
+// "{{$tag.Tag}}"-specific typed attributes.
+func (s *Selection) {{$tag.As}}() *{{$tag.Type}} {
+	return &{{$tag.Type}}{s}
+}
+{{range $tag.Attributes}}
+func (s *{{$tag.Type}}) {{.Name}}() ({{kindType .}}, error) {
+	value, err := s.GetAttribute("{{.Attribute}}")
+	if err != nil {
+		return {{kindZero .}}, err
+	}
+	{{kindParse . "value"}}
+}
+{{if .Settable}}
+// Set{{.Name}} clears the element and fills it with value, the same way
+// Fill does for an untyped Selection.
+func (s *{{$tag.Type}}) Set{{.Name}}(value {{kindType .}}) error {
+	return s.Fill(value)
+}
+{{end}}{{end}}{{end}}
+
+// selToElement inspects the tag name (and, where the table calls for it,
+// an attribute) of a selection's underlying element and returns the typed
+// wrapper appropriate to it. It returns the selection unchanged, as a
+// *Selection, if no entry in tags.yaml matches.
+func selToElement(s *Selection) (interface{}, error) {
+	name, err := s.GetName()
+	if err != nil {
+		return nil, err
+	}
+{{range .Tags}}
+	if name == "{{.Tag}}" {
+{{if .MatchAttribute}}		if value, err := s.GetAttribute("{{.MatchAttribute}}"); err == nil && value == "{{.MatchValue}}" {
+			return s.{{.As}}(), nil
+		}
+{{else}}		return s.{{.As}}(), nil
+{{end}}	}
+{{end}}
+	return s, nil
+}
+`))
+
+// kindType, kindZero, and kindParse render the Go type, zero value, and
+// getter body for an attribute's kind. Every kind but enum is just a Go
+// type; enum is a string validated against attr.Values.
+func kindType(attr attribute) string {
+	switch attr.Kind {
+	case "bool":
+		return "bool"
+	case "int":
+		return "int"
+	case "url":
+		return "*url.URL"
+	default:
+		return "string"
+	}
+}
+
+func kindZero(attr attribute) string {
+	switch attr.Kind {
+	case "bool":
+		return "false"
+	case "int":
+		return "0"
+	case "url":
+		return "nil"
+	default:
+		return `""`
+	}
+}
+
+func kindParse(attr attribute, variable string) string {
+	switch attr.Kind {
+	case "bool":
+		return fmt.Sprintf("return %s == \"true\", nil", variable)
+	case "int":
+		return fmt.Sprintf("return strconv.Atoi(%s)", variable)
+	case "url":
+		return fmt.Sprintf("return url.Parse(%s)", variable)
+	case "enum":
+		quoted := make([]string, len(attr.Values))
+		for i, v := range attr.Values {
+			quoted[i] = fmt.Sprintf("%q", v)
+		}
+		return fmt.Sprintf(`switch %s {
+case %s:
+	return %s, nil
+default:
+	return "", fmt.Errorf("unrecognized value %%q for %s", %s)
+}`, variable, strings.Join(quoted, ", "), variable, attr.Name, variable)
+	default:
+		return fmt.Sprintf("return %s, nil", variable)
+	}
+}
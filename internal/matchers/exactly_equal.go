@@ -0,0 +1,31 @@
+// Package matchers provides Gomega matchers used by agouti's own test
+// suite that aren't part of the standard Gomega matcher set.
+package matchers
+
+import (
+	"fmt"
+
+	"github.com/onsi/gomega/format"
+)
+
+// ExactlyEqual succeeds when actual is the identical value (same pointer,
+// for pointer types) as expected, rather than merely deeply equal to it.
+func ExactlyEqual(expected interface{}) *exactlyEqualMatcher {
+	return &exactlyEqualMatcher{expected: expected}
+}
+
+type exactlyEqualMatcher struct {
+	expected interface{}
+}
+
+func (m *exactlyEqualMatcher) Match(actual interface{}) (bool, error) {
+	return actual == m.expected, nil
+}
+
+func (m *exactlyEqualMatcher) FailureMessage(actual interface{}) string {
+	return fmt.Sprintf("Expected\n%s\nto be identical to\n%s", format.Object(actual, 1), format.Object(m.expected, 1))
+}
+
+func (m *exactlyEqualMatcher) NegatedFailureMessage(actual interface{}) string {
+	return fmt.Sprintf("Expected\n%s\nnot to be identical to\n%s", format.Object(actual, 1), format.Object(m.expected, 1))
+}
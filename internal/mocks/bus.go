@@ -0,0 +1,20 @@
+package mocks
+
+// Bus mocks api.Bus.
+type Bus struct {
+	SendCall struct {
+		Method   string
+		Endpoint string
+		Body     interface{}
+		Result   interface{}
+		Err      error
+	}
+}
+
+func (b *Bus) Send(method, endpoint string, body, result interface{}) error {
+	b.SendCall.Method = method
+	b.SendCall.Endpoint = endpoint
+	b.SendCall.Body = body
+	b.SendCall.Result = result
+	return b.SendCall.Err
+}
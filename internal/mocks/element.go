@@ -0,0 +1,94 @@
+package mocks
+
+import "github.com/sclevine/agouti/api"
+
+// Element mocks element.Element.
+type Element struct {
+	ClickCall struct {
+		Called bool
+		Err    error
+	}
+
+	ClearCall struct {
+		Called bool
+		Err    error
+	}
+
+	ValueCall struct {
+		Text string
+		Err  error
+	}
+
+	SubmitCall struct {
+		Called bool
+		Err    error
+	}
+
+	GetAttributeCall struct {
+		Attribute string
+		// ReturnValues, if non-nil, supplies a return value per
+		// attribute name, for tests that need GetAttribute to answer
+		// differently depending on which attribute was requested.
+		// Otherwise ReturnValue is used for every attribute.
+		ReturnValues map[string]string
+		ReturnValue  string
+		Err          error
+	}
+
+	GetNameCall struct {
+		ReturnName string
+		Err        error
+	}
+
+	IsSelectedCall struct {
+		ReturnSelected bool
+		Err            error
+	}
+
+	GetElementsCall struct {
+		Selector       api.Selector
+		ReturnElements []*api.Element
+		Err            error
+	}
+}
+
+func (e *Element) Click() error {
+	e.ClickCall.Called = true
+	return e.ClickCall.Err
+}
+
+func (e *Element) Clear() error {
+	e.ClearCall.Called = true
+	return e.ClearCall.Err
+}
+
+func (e *Element) Value(text string) error {
+	e.ValueCall.Text = text
+	return e.ValueCall.Err
+}
+
+func (e *Element) Submit() error {
+	e.SubmitCall.Called = true
+	return e.SubmitCall.Err
+}
+
+func (e *Element) GetAttribute(attribute string) (string, error) {
+	e.GetAttributeCall.Attribute = attribute
+	if e.GetAttributeCall.ReturnValues != nil {
+		return e.GetAttributeCall.ReturnValues[attribute], e.GetAttributeCall.Err
+	}
+	return e.GetAttributeCall.ReturnValue, e.GetAttributeCall.Err
+}
+
+func (e *Element) GetName() (string, error) {
+	return e.GetNameCall.ReturnName, e.GetNameCall.Err
+}
+
+func (e *Element) IsSelected() (bool, error) {
+	return e.IsSelectedCall.ReturnSelected, e.IsSelectedCall.Err
+}
+
+func (e *Element) GetElements(selector api.Selector) ([]*api.Element, error) {
+	e.GetElementsCall.Selector = selector
+	return e.GetElementsCall.ReturnElements, e.GetElementsCall.Err
+}
@@ -0,0 +1,35 @@
+package mocks
+
+import (
+	"sync"
+
+	"github.com/sclevine/agouti/internal/element"
+)
+
+// ElementRepository mocks element.Repository. Its mutex guards
+// GetAtLeastOneCall.Err so tests that flip the mocked error from a
+// goroutine (e.g. to exercise WithTimeout's retry loop) can do so safely;
+// use SetErr rather than writing the field directly from another
+// goroutine.
+type ElementRepository struct {
+	mu sync.Mutex
+
+	GetAtLeastOneCall struct {
+		ReturnElements []element.Element
+		Err            error
+	}
+}
+
+func (r *ElementRepository) GetAtLeastOne() ([]element.Element, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.GetAtLeastOneCall.ReturnElements, r.GetAtLeastOneCall.Err
+}
+
+// SetErr sets GetAtLeastOneCall.Err under the mock's lock, for use from a
+// goroutine running concurrently with GetAtLeastOne.
+func (r *ElementRepository) SetErr(err error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.GetAtLeastOneCall.Err = err
+}
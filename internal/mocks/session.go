@@ -0,0 +1,46 @@
+package mocks
+
+import (
+	"sync"
+
+	"github.com/sclevine/agouti/api"
+)
+
+// Session mocks the session-scoped commands that selection actions such as
+// DoubleClick issue directly against a session rather than an element.
+type Session struct {
+	sync.Mutex
+
+	MoveToCall struct {
+		Element *api.Element
+		Offset  *api.Point
+		Err     error
+	}
+
+	DoubleClickCall struct {
+		Called bool
+		Err    error
+	}
+
+	GetElementsCall struct {
+		Selector       api.Selector
+		ReturnElements []*api.Element
+		Err            error
+	}
+}
+
+func (s *Session) MoveTo(element *api.Element, offset *api.Point) error {
+	s.MoveToCall.Element = element
+	s.MoveToCall.Offset = offset
+	return s.MoveToCall.Err
+}
+
+func (s *Session) DoubleClick() error {
+	s.DoubleClickCall.Called = true
+	return s.DoubleClickCall.Err
+}
+
+func (s *Session) GetElements(selector api.Selector) ([]*api.Element, error) {
+	s.GetElementsCall.Selector = selector
+	return s.GetElementsCall.ReturnElements, s.GetElementsCall.Err
+}
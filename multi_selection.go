@@ -0,0 +1,46 @@
+package agouti
+
+import (
+	"time"
+
+	"github.com/sclevine/agouti/api"
+	"github.com/sclevine/agouti/internal/element"
+)
+
+// session is the subset of a WebDriver session that selection actions
+// issue directly, rather than through a located element, plus the
+// root-level element lookup Page.Find uses to build a selection in the
+// first place. Lock/Unlock serialize the session-scoped calls (MoveTo,
+// DoubleClick) when a selection's actions run across a worker pool (see
+// Parallel).
+type session interface {
+	MoveTo(element *api.Element, offset *api.Point) error
+	DoubleClick() error
+	GetElements(selector api.Selector) ([]*api.Element, error)
+	Lock()
+	Unlock()
+}
+
+// MultiSelection represents a collection of DOM elements matched by a
+// selector chain. All exported methods that act on a MultiSelection apply
+// to every element it matches.
+type MultiSelection struct {
+	selectorString string
+	session        session
+	elements       element.Repository
+	timeout        time.Duration
+	interval       time.Duration
+
+	// parallelism is the number of elements an action is allowed to act on
+	// concurrently. 0 or 1 means actions run sequentially, stopping at the
+	// first error, which is also the default. See Parallel.
+	parallelism int
+
+	// parent is the selection this one was derived from via a traversal
+	// method (First, Eq, Filter, ...), if any. End rolls back to it.
+	parent *MultiSelection
+}
+
+func (m *MultiSelection) String() string {
+	return m.selectorString
+}
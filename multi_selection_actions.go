@@ -0,0 +1,350 @@
+package agouti
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/sclevine/agouti/api"
+	"github.com/sclevine/agouti/internal/element"
+)
+
+// Click clicks on each element in the selection. If the selection has a
+// timeout set (see WithTimeout), a failed attempt is retried until it
+// succeeds or the timeout elapses.
+func (m *MultiSelection) Click() error {
+	return m.retry(m.clickOnce)
+}
+
+func (m *MultiSelection) clickOnce() error {
+	elements, err := m.elements.GetAtLeastOne()
+	if err != nil {
+		return fmt.Errorf("failed to select elements from selection '%s': %s", m, err)
+	}
+
+	err = m.forEachElement(elements, func(selectedElement element.Element) error {
+		return selectedElement.Click()
+	})
+	if err != nil {
+		return fmt.Errorf("failed to click on selection '%s': %s", m, err)
+	}
+
+	return nil
+}
+
+// DoubleClick moves the mouse to the center of each element in the
+// selection and double-clicks. The underlying MoveTo/DoubleClick pair is
+// session-scoped rather than element-scoped, so it is serialized behind
+// the session's lock even when the selection has been made Parallel.
+func (m *MultiSelection) DoubleClick() error {
+	return m.retry(m.doubleClickOnce)
+}
+
+func (m *MultiSelection) doubleClickOnce() error {
+	elements, err := m.elements.GetAtLeastOne()
+	if err != nil {
+		return fmt.Errorf("failed to select elements from selection '%s': %s", m, err)
+	}
+
+	if m.parallelism <= 1 || len(elements) <= 1 {
+		for _, selectedElement := range elements {
+			apiElement, ok := selectedElement.(*api.Element)
+			if !ok {
+				continue
+			}
+			if err := m.session.MoveTo(apiElement, nil); err != nil {
+				return fmt.Errorf("failed to move mouse to selection '%s': %s", m, err)
+			}
+			if err := m.session.DoubleClick(); err != nil {
+				return fmt.Errorf("failed to double-click on selection '%s': %s", m, err)
+			}
+		}
+		return nil
+	}
+
+	err = m.forEachElementParallel(elements, func(selectedElement element.Element) error {
+		apiElement, ok := selectedElement.(*api.Element)
+		if !ok {
+			return nil
+		}
+
+		m.session.Lock()
+		defer m.session.Unlock()
+
+		if err := m.session.MoveTo(apiElement, nil); err != nil {
+			return fmt.Errorf("failed to move mouse: %s", err)
+		}
+		if err := m.session.DoubleClick(); err != nil {
+			return fmt.Errorf("failed to double-click: %s", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("failed to double-click on selection '%s': %s", m, err)
+	}
+
+	return nil
+}
+
+// Fill clears each element in the selection and enters the provided text.
+func (m *MultiSelection) Fill(text string) error {
+	return m.retry(func() error {
+		return m.fillOnce(text)
+	})
+}
+
+func (m *MultiSelection) fillOnce(text string) error {
+	elements, err := m.elements.GetAtLeastOne()
+	if err != nil {
+		return fmt.Errorf("failed to select elements from selection '%s': %s", m, err)
+	}
+
+	err = m.forEachElement(elements, func(selectedElement element.Element) error {
+		return selectedElement.Clear()
+	})
+	if err != nil {
+		return fmt.Errorf("failed to clear selection '%s': %s", m, err)
+	}
+
+	err = m.forEachElement(elements, func(selectedElement element.Element) error {
+		return selectedElement.Value(text)
+	})
+	if err != nil {
+		return fmt.Errorf("failed to enter text into selection '%s': %s", m, err)
+	}
+
+	return nil
+}
+
+// UploadFile enters the absolute path of one or more existing files into
+// each <input type="file"> element in the selection; for a real WebDriver
+// element, each file is uploaded to the session first and the path it
+// returns is entered instead (see referencedPaths). Uploading more than
+// one path requires the element to have a "multiple" attribute.
+func (m *MultiSelection) UploadFile(paths ...string) error {
+	return m.retry(func() error {
+		return m.uploadFilesOnce(paths)
+	})
+}
+
+// UploadFiles is an alias for UploadFile, for readability at call sites
+// that upload more than one file.
+func (m *MultiSelection) UploadFiles(paths ...string) error {
+	return m.UploadFile(paths...)
+}
+
+// UploadDirectory walks root and uploads every regular file found beneath
+// it, as UploadFile would. Directories and non-regular files (symlinks,
+// devices, ...) are skipped.
+func (m *MultiSelection) UploadDirectory(root string) error {
+	var paths []string
+	err := filepath.Walk(root, func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		if !info.Mode().IsRegular() {
+			return nil
+		}
+		paths = append(paths, path)
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("failed to walk directory %q: %s", root, err)
+	}
+
+	return m.UploadFile(paths...)
+}
+
+func (m *MultiSelection) uploadFilesOnce(paths []string) error {
+	elements, err := m.elements.GetAtLeastOne()
+	if err != nil {
+		return fmt.Errorf("failed to select elements from selection '%s': %s", m, err)
+	}
+
+	absolutePaths := make([]string, len(paths))
+	for i, path := range paths {
+		absolutePath, err := filepath.Abs(path)
+		if err != nil {
+			return fmt.Errorf("failed to find absolute path for file: %s", err)
+		}
+		absolutePaths[i] = absolutePath
+	}
+
+	for _, selectedElement := range elements {
+		name, err := selectedElement.GetName()
+		if err != nil {
+			return fmt.Errorf("failed to determine tag name of selection '%s': %s", m, err)
+		}
+		if name != "input" {
+			return permanentf("element for selection '%s' is not an input element", m)
+		}
+
+		elementType, err := selectedElement.GetAttribute("type")
+		if err != nil {
+			return fmt.Errorf("failed to determine type attribute of selection '%s': %s", m, err)
+		}
+		if elementType != "file" {
+			return permanentf("element for selection '%s' is not a file uploader", m)
+		}
+
+		if len(absolutePaths) > 1 {
+			multiple, err := selectedElement.GetAttribute("multiple")
+			if err != nil {
+				return fmt.Errorf("failed to determine multiple attribute of selection '%s': %s", m, err)
+			}
+			if multiple == "" {
+				return permanentf("element for selection '%s' does not accept multiple files", m)
+			}
+		}
+	}
+
+	for _, selectedElement := range elements {
+		value, err := referencedPaths(selectedElement, absolutePaths)
+		if err != nil {
+			return fmt.Errorf("failed to upload files for selection '%s': %s", m, err)
+		}
+		if err := selectedElement.Value(strings.Join(value, "\n")); err != nil {
+			return fmt.Errorf("failed to enter text into selection '%s': %s", m, err)
+		}
+	}
+
+	return nil
+}
+
+// referencedPaths returns the paths that should be sent to selectedElement
+// for the given local, absolute file paths. For a real WebDriver element,
+// each file is first zipped and shipped to the session via its
+// "/session/:id/file" endpoint, and the server-side path it returns is
+// used in place of the (session-local) path.
+func referencedPaths(selectedElement element.Element, absolutePaths []string) ([]string, error) {
+	apiElement, ok := selectedElement.(*api.Element)
+	if !ok {
+		return absolutePaths, nil
+	}
+
+	referenced := make([]string, len(absolutePaths))
+	for i, path := range absolutePaths {
+		serverPath, err := apiElement.Session.UploadFile(path)
+		if err != nil {
+			return nil, err
+		}
+		referenced[i] = serverPath
+	}
+	return referenced, nil
+}
+
+// Check clicks each unchecked checkbox in the selection.
+func (m *MultiSelection) Check() error {
+	return m.retry(func() error {
+		return m.setCheckedOnce(true)
+	})
+}
+
+// Uncheck clicks each checked checkbox in the selection.
+func (m *MultiSelection) Uncheck() error {
+	return m.retry(func() error {
+		return m.setCheckedOnce(false)
+	})
+}
+
+func (m *MultiSelection) setCheckedOnce(checked bool) error {
+	elements, err := m.elements.GetAtLeastOne()
+	if err != nil {
+		return fmt.Errorf("failed to select elements from selection '%s': %s", m, err)
+	}
+
+	for _, selectedElement := range elements {
+		elementType, err := selectedElement.GetAttribute("type")
+		if err != nil {
+			return fmt.Errorf("failed to retrieve type attribute of selection '%s': %s", m, err)
+		}
+		if elementType != "checkbox" {
+			return permanentf("selection '%s' does not refer to a checkbox", m)
+		}
+	}
+
+	var toClick []element.Element
+	for _, selectedElement := range elements {
+		selected, err := selectedElement.IsSelected()
+		if err != nil {
+			return fmt.Errorf("failed to retrieve state of selection '%s': %s", m, err)
+		}
+		if selected != checked {
+			toClick = append(toClick, selectedElement)
+		}
+	}
+
+	err = m.forEachElement(toClick, func(selectedElement element.Element) error {
+		return selectedElement.Click()
+	})
+	if err != nil {
+		return fmt.Errorf("failed to click on selection '%s': %s", m, err)
+	}
+
+	return nil
+}
+
+// Select clicks the <option> with the given text in each <select>
+// element in the selection.
+func (m *MultiSelection) Select(text string) error {
+	return m.retry(func() error {
+		return m.selectOnce(text)
+	})
+}
+
+func (m *MultiSelection) selectOnce(text string) error {
+	elements, err := m.elements.GetAtLeastOne()
+	if err != nil {
+		return fmt.Errorf("failed to select elements from selection '%s': %s", m, err)
+	}
+
+	selector := api.Selector{Using: "xpath", Value: fmt.Sprintf(`./option[normalize-space()="%s"]`, text)}
+
+	var matchedOptions []*api.Element
+	for _, selectedElement := range elements {
+		options, err := selectedElement.GetElements(selector)
+		if err != nil {
+			return fmt.Errorf("failed to select specified option for selection '%s': %s", m, err)
+		}
+		if len(options) == 0 {
+			return fmt.Errorf("no options with text %q found for selection '%s'", text, m)
+		}
+		matchedOptions = append(matchedOptions, options...)
+	}
+
+	optionElements := make([]element.Element, len(matchedOptions))
+	for i, option := range matchedOptions {
+		optionElements[i] = option
+	}
+
+	err = m.forEachElement(optionElements, func(selectedElement element.Element) error {
+		return selectedElement.Click()
+	})
+	if err != nil {
+		return fmt.Errorf("failed to click on option with text %q for selection '%s': %s", text, m, err)
+	}
+
+	return nil
+}
+
+// Submit submits each element in the selection.
+func (m *MultiSelection) Submit() error {
+	return m.retry(m.submitOnce)
+}
+
+func (m *MultiSelection) submitOnce() error {
+	elements, err := m.elements.GetAtLeastOne()
+	if err != nil {
+		return fmt.Errorf("failed to select elements from selection '%s': %s", m, err)
+	}
+
+	err = m.forEachElement(elements, func(selectedElement element.Element) error {
+		return selectedElement.Submit()
+	})
+	if err != nil {
+		return fmt.Errorf("failed to submit selection '%s': %s", m, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,87 @@
+package agouti
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/sclevine/agouti/internal/element"
+)
+
+// Parallel returns a copy of the selection whose actions (Click, Fill,
+// Check, Uncheck, Submit, Select, DoubleClick) act on up to n elements at
+// once, instead of one at a time. This is useful for selections matching a
+// large number of elements. A failure on one element does not stop the
+// others; every failure is reported, annotated with the index of the
+// element that produced it. n <= 1 restores the sequential, fail-fast
+// default.
+func (m *MultiSelection) Parallel(n int) *MultiSelection {
+	selectionCopy := *m
+	selectionCopy.parallelism = n
+	return &selectionCopy
+}
+
+// forEachElement calls fn for each of elements, running them one at a time
+// and stopping at the first error by default, or across up to
+// m.parallelism workers at once (collecting every error) when Parallel has
+// been set.
+func (m *MultiSelection) forEachElement(elements []element.Element, fn func(element.Element) error) error {
+	if m.parallelism <= 1 || len(elements) <= 1 {
+		for _, selectedElement := range elements {
+			if err := fn(selectedElement); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
+	return m.forEachElementParallel(elements, fn)
+}
+
+func (m *MultiSelection) forEachElementParallel(elements []element.Element, fn func(element.Element) error) error {
+	tokens := make(chan struct{}, m.parallelism)
+	results := make([]error, len(elements))
+
+	done := make(chan struct{})
+	for i := range elements {
+		i := i
+		tokens <- struct{}{}
+		go func() {
+			defer func() { <-tokens; done <- struct{}{} }()
+			results[i] = fn(elements[i])
+		}()
+	}
+	for range elements {
+		<-done
+	}
+
+	var failures []error
+	for index, err := range results {
+		if err != nil {
+			failures = append(failures, fmt.Errorf("element %d: %s", index, err))
+		}
+	}
+	if len(failures) == 0 {
+		return nil
+	}
+	return &multiElementError{errs: failures}
+}
+
+// multiElementError collects the errors produced by a parallel action,
+// formatted as a bracketed, comma-separated list (e.g. "[element 0: ...,
+// element 3: ...]"). Unwrap exposes the individual errors for errors.Is
+// and errors.As, per the Go 1.20 multi-error convention.
+type multiElementError struct {
+	errs []error
+}
+
+func (e *multiElementError) Error() string {
+	messages := make([]string, len(e.errs))
+	for i, err := range e.errs {
+		messages[i] = err.Error()
+	}
+	return "[" + strings.Join(messages, ", ") + "]"
+}
+
+func (e *multiElementError) Unwrap() []error {
+	return e.errs
+}
@@ -0,0 +1,167 @@
+package agouti_test
+
+import (
+	"errors"
+	"sync"
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/sclevine/agouti"
+	"github.com/sclevine/agouti/api"
+	"github.com/sclevine/agouti/internal/element"
+	"github.com/sclevine/agouti/internal/mocks"
+)
+
+var _ = Describe("MultiSelection parallel actions", func() {
+	var (
+		selection         *MultiSelection
+		elements          []*mocks.Element
+		elementRepository *mocks.ElementRepository
+	)
+
+	BeforeEach(func() {
+		elements = nil
+		for i := 0; i < 4; i++ {
+			elements = append(elements, &mocks.Element{})
+		}
+
+		var repoElements []element.Element
+		for _, e := range elements {
+			repoElements = append(repoElements, e)
+		}
+
+		elementRepository = &mocks.ElementRepository{}
+		elementRepository.GetAtLeastOneCall.ReturnElements = repoElements
+		selection = NewTestMultiSelection(&mocks.Session{}, elementRepository, "#selector").Parallel(2)
+	})
+
+	Describe("#Click", func() {
+		It("should click on every element", func() {
+			Expect(selection.Click()).To(Succeed())
+			for _, e := range elements {
+				Expect(e.ClickCall.Called).To(BeTrue())
+			}
+		})
+
+		Context("when more than one element fails", func() {
+			It("should report every failure, tagged with its element index", func() {
+				elements[1].ClickCall.Err = errors.New("stale")
+				elements[3].ClickCall.Err = errors.New("not visible")
+
+				err := selection.Click()
+				Expect(err).To(HaveOccurred())
+				Expect(err.Error()).To(ContainSubstring("failed to click on selection 'CSS: #selector':"))
+				Expect(err.Error()).To(ContainSubstring("element 1: stale"))
+				Expect(err.Error()).To(ContainSubstring("element 3: not visible"))
+			})
+		})
+	})
+
+	Describe("#Fill", func() {
+		It("should clear and enter text into every element", func() {
+			Expect(selection.Fill("some text")).To(Succeed())
+			for _, e := range elements {
+				Expect(e.ClearCall.Called).To(BeTrue())
+				Expect(e.ValueCall.Text).To(Equal("some text"))
+			}
+		})
+	})
+
+	Describe("#Submit", func() {
+		It("should submit every element", func() {
+			Expect(selection.Submit()).To(Succeed())
+			for _, e := range elements {
+				Expect(e.SubmitCall.Called).To(BeTrue())
+			}
+		})
+	})
+
+	Describe("#Check", func() {
+		It("should click only the elements that need to change state", func() {
+			for _, e := range elements {
+				e.GetAttributeCall.ReturnValue = "checkbox"
+			}
+			elements[0].IsSelectedCall.ReturnSelected = true
+			elements[1].IsSelectedCall.ReturnSelected = false
+
+			Expect(selection.Check()).To(Succeed())
+			Expect(elements[0].ClickCall.Called).To(BeFalse())
+			Expect(elements[1].ClickCall.Called).To(BeTrue())
+		})
+	})
+
+	Describe("a selection with parallelism of 1 or less", func() {
+		It("should stop at the first error, as if no Parallel had been set", func() {
+			sequential := NewTestMultiSelection(&mocks.Session{}, elementRepository, "#selector").Parallel(1)
+			elements[0].ClickCall.Err = errors.New("first error")
+			elements[1].ClickCall.Err = errors.New("second error")
+
+			err := sequential.Click()
+			Expect(err).To(MatchError("failed to click on selection 'CSS: #selector': first error"))
+			Expect(elements[2].ClickCall.Called).To(BeFalse())
+		})
+	})
+
+	Describe("#DoubleClick", func() {
+		It("should still succeed when run in parallel", func() {
+			session := &mocks.Session{}
+			repo := &mocks.ElementRepository{}
+			repo.GetAtLeastOneCall.ReturnElements = []element.Element{&api.Element{}, &api.Element{}}
+			doubleClickSelection := NewTestMultiSelection(session, repo, "#selector").Parallel(4)
+
+			Expect(doubleClickSelection.DoubleClick()).To(Succeed())
+			Expect(session.DoubleClickCall.Called).To(BeTrue())
+		})
+	})
+
+	Describe("fan-out concurrency", func() {
+		It("should run no more than the configured number of workers at once", func() {
+			var (
+				mu      sync.Mutex
+				current int
+				peak    int
+			)
+
+			many := make([]*mocks.Element, 10)
+			var repoElements []element.Element
+			for i := range many {
+				many[i] = &mocks.Element{}
+				repoElements = append(repoElements, &trackingElement{Element: many[i], before: func() {
+					mu.Lock()
+					current++
+					if current > peak {
+						peak = current
+					}
+					mu.Unlock()
+					time.Sleep(5 * time.Millisecond)
+				}, after: func() {
+					mu.Lock()
+					current--
+					mu.Unlock()
+				}})
+			}
+
+			repo := &mocks.ElementRepository{}
+			repo.GetAtLeastOneCall.ReturnElements = repoElements
+			bounded := NewTestMultiSelection(&mocks.Session{}, repo, "#selector").Parallel(3)
+
+			Expect(bounded.Click()).To(Succeed())
+			Expect(peak).To(BeNumerically("<=", 3))
+			Expect(peak).To(BeNumerically(">", 1))
+		})
+	})
+})
+
+// trackingElement wraps a mocks.Element to observe how many Click calls are
+// in flight at once, without changing the mock's recorded call data.
+type trackingElement struct {
+	*mocks.Element
+	before, after func()
+}
+
+func (t *trackingElement) Click() error {
+	t.before()
+	defer t.after()
+	return t.Element.Click()
+}
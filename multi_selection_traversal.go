@@ -0,0 +1,214 @@
+package agouti
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/sclevine/agouti/internal/element"
+)
+
+// First returns a selection matching only the first element of the
+// current selection.
+func (m *MultiSelection) First() *MultiSelection {
+	return m.Eq(0)
+}
+
+// Last returns a selection matching only the last element of the current
+// selection.
+func (m *MultiSelection) Last() *MultiSelection {
+	return m.Eq(-1)
+}
+
+// Eq returns a selection matching only the element at index, which may be
+// negative to count back from the end of the current selection.
+func (m *MultiSelection) Eq(index int) *MultiSelection {
+	return m.withRepository(fmt.Sprintf(" [eq %d]", index), &indexRepository{parent: m.elements, index: index})
+}
+
+// Slice returns a selection matching the elements of the current
+// selection from start up to, but not including, end. Either bound may be
+// negative to count back from the end of the current selection.
+func (m *MultiSelection) Slice(start, end int) *MultiSelection {
+	return m.withRepository(fmt.Sprintf(" [%d:%d]", start, end), &rangeRepository{parent: m.elements, start: start, end: end})
+}
+
+// Filter returns a selection matching only the elements for which keep
+// returns true. keep is called with each element's index in the current
+// selection and a Selection matching that element alone.
+func (m *MultiSelection) Filter(keep func(int, *Selection) bool) *MultiSelection {
+	predicate := func(index int, matchedElement element.Element) bool {
+		return keep(index, m.elementAt(index, matchedElement))
+	}
+	return m.withRepository(" [filtered]", &predicateRepository{parent: m.elements, keep: predicate})
+}
+
+// Not returns a selection matching only the elements for which exclude
+// returns false. It is the complement of Filter.
+func (m *MultiSelection) Not(exclude func(int, *Selection) bool) *MultiSelection {
+	return m.Filter(func(index int, selection *Selection) bool {
+		return !exclude(index, selection)
+	})
+}
+
+// Map calls fn with the index and a Selection matching each element of
+// the current selection, and returns the results in order. It returns nil
+// if the current selection cannot be resolved.
+func (m *MultiSelection) Map(fn func(int, *Selection) string) []string {
+	elements, err := m.elements.GetAtLeastOne()
+	if err != nil {
+		return nil
+	}
+
+	results := make([]string, len(elements))
+	for index, matchedElement := range elements {
+		results[index] = fn(index, m.elementAt(index, matchedElement))
+	}
+	return results
+}
+
+// Each calls fn with the index and a Selection matching each element of
+// the current selection, in order, stopping at and returning the first
+// error fn returns.
+func (m *MultiSelection) Each(fn func(int, *Selection) error) error {
+	elements, err := m.elements.GetAtLeastOne()
+	if err != nil {
+		return fmt.Errorf("failed to select elements from selection '%s': %s", m, err)
+	}
+
+	for index, matchedElement := range elements {
+		if err := fn(index, m.elementAt(index, matchedElement)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// End returns the selection this one was derived from via a traversal
+// method such as First, Eq, Slice, Filter, or Not. It returns the
+// selection unchanged if it has no parent.
+func (m *MultiSelection) End() *MultiSelection {
+	if m.parent == nil {
+		return m
+	}
+	return m.parent
+}
+
+// withRepository returns a selection derived from m backed by repository,
+// with suffix appended to m's selector string for error messages (e.g.
+// "CSS: #selector [eq 2]").
+func (m *MultiSelection) withRepository(suffix string, repository element.Repository) *MultiSelection {
+	return &MultiSelection{
+		selectorString: m.selectorString + suffix,
+		session:        m.session,
+		elements:       repository,
+		timeout:        m.timeout,
+		interval:       m.interval,
+		parallelism:    m.parallelism,
+		parent:         m,
+	}
+}
+
+// elementAt wraps a single already-located element as a Selection, for
+// use inside Filter, Not, Map, and Each callbacks.
+func (m *MultiSelection) elementAt(index int, matchedElement element.Element) *Selection {
+	return &Selection{m.withRepository(fmt.Sprintf(" [eq %d]", index), &singleElementRepository{element: matchedElement})}
+}
+
+// indexRepository narrows a repository to the element at index (which may
+// be negative to count from the end).
+type indexRepository struct {
+	parent element.Repository
+	index  int
+}
+
+func (r *indexRepository) GetAtLeastOne() ([]element.Element, error) {
+	elements, err := r.parent.GetAtLeastOne()
+	if err != nil {
+		return nil, err
+	}
+
+	index := r.index
+	if index < 0 {
+		index += len(elements)
+	}
+	if index < 0 || index >= len(elements) {
+		return nil, fmt.Errorf("element index %d is out of range", r.index)
+	}
+
+	return elements[index : index+1], nil
+}
+
+// rangeRepository narrows a repository to the elements from start up to,
+// but not including, end (either of which may be negative to count from
+// the end).
+type rangeRepository struct {
+	parent     element.Repository
+	start, end int
+}
+
+func (r *rangeRepository) GetAtLeastOne() ([]element.Element, error) {
+	elements, err := r.parent.GetAtLeastOne()
+	if err != nil {
+		return nil, err
+	}
+
+	length := len(elements)
+	start, end := resolveIndex(r.start, length), resolveIndex(r.end, length)
+	if start < 0 {
+		start = 0
+	}
+	if end > length {
+		end = length
+	}
+	if start > end {
+		start = end
+	}
+
+	sliced := elements[start:end]
+	if len(sliced) == 0 {
+		return nil, errors.New("no elements in range")
+	}
+	return sliced, nil
+}
+
+func resolveIndex(index, length int) int {
+	if index < 0 {
+		return length + index
+	}
+	return index
+}
+
+// predicateRepository narrows a repository to the elements keep returns
+// true for.
+type predicateRepository struct {
+	parent element.Repository
+	keep   func(index int, matchedElement element.Element) bool
+}
+
+func (r *predicateRepository) GetAtLeastOne() ([]element.Element, error) {
+	elements, err := r.parent.GetAtLeastOne()
+	if err != nil {
+		return nil, err
+	}
+
+	var kept []element.Element
+	for index, matchedElement := range elements {
+		if r.keep(index, matchedElement) {
+			kept = append(kept, matchedElement)
+		}
+	}
+	if len(kept) == 0 {
+		return nil, errors.New("no elements matched the filter")
+	}
+	return kept, nil
+}
+
+// singleElementRepository wraps an already-located element as a
+// Repository, for Selections produced by traversal callbacks.
+type singleElementRepository struct {
+	element element.Element
+}
+
+func (r *singleElementRepository) GetAtLeastOne() ([]element.Element, error) {
+	return []element.Element{r.element}, nil
+}
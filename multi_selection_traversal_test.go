@@ -0,0 +1,151 @@
+package agouti_test
+
+import (
+	"errors"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/sclevine/agouti"
+	"github.com/sclevine/agouti/internal/element"
+	"github.com/sclevine/agouti/internal/mocks"
+)
+
+var _ = Describe("MultiSelection traversal", func() {
+	var (
+		selection         *MultiSelection
+		elementRepository *mocks.ElementRepository
+		elements          []*mocks.Element
+	)
+
+	BeforeEach(func() {
+		elements = []*mocks.Element{{}, {}, {}}
+		elementRepository = &mocks.ElementRepository{}
+		elementRepository.GetAtLeastOneCall.ReturnElements = []element.Element{elements[0], elements[1], elements[2]}
+		selection = NewTestMultiSelection(&mocks.Session{}, elementRepository, "#selector")
+	})
+
+	Describe("#First", func() {
+		It("should act only on the first element", func() {
+			Expect(selection.First().Click()).To(Succeed())
+			Expect(elements[0].ClickCall.Called).To(BeTrue())
+			Expect(elements[1].ClickCall.Called).To(BeFalse())
+		})
+
+		It("should describe itself with an '[eq 0]' suffix", func() {
+			Expect(selection.First().String()).To(Equal("CSS: #selector [eq 0]"))
+		})
+	})
+
+	Describe("#Last", func() {
+		It("should act only on the last element", func() {
+			Expect(selection.Last().Click()).To(Succeed())
+			Expect(elements[2].ClickCall.Called).To(BeTrue())
+			Expect(elements[0].ClickCall.Called).To(BeFalse())
+		})
+	})
+
+	Describe("#Eq", func() {
+		It("should support negative indexing from the end", func() {
+			Expect(selection.Eq(-2).Click()).To(Succeed())
+			Expect(elements[1].ClickCall.Called).To(BeTrue())
+		})
+
+		Context("when the index is out of range", func() {
+			It("should return an error", func() {
+				err := selection.Eq(5).Click()
+				Expect(err).To(HaveOccurred())
+			})
+		})
+	})
+
+	Describe("#Slice", func() {
+		It("should act only on the elements in range", func() {
+			Expect(selection.Slice(1, 3).Click()).To(Succeed())
+			Expect(elements[0].ClickCall.Called).To(BeFalse())
+			Expect(elements[1].ClickCall.Called).To(BeTrue())
+			Expect(elements[2].ClickCall.Called).To(BeTrue())
+		})
+	})
+
+	Describe("#Filter", func() {
+		It("should act only on the elements that satisfy the predicate", func() {
+			filtered := selection.Filter(func(index int, s *Selection) bool {
+				return index != 1
+			})
+			Expect(filtered.Click()).To(Succeed())
+			Expect(elements[0].ClickCall.Called).To(BeTrue())
+			Expect(elements[1].ClickCall.Called).To(BeFalse())
+			Expect(elements[2].ClickCall.Called).To(BeTrue())
+		})
+
+		Context("when nothing matches", func() {
+			It("should return an error", func() {
+				filtered := selection.Filter(func(int, *Selection) bool { return false })
+				Expect(filtered.Click()).To(HaveOccurred())
+			})
+		})
+	})
+
+	Describe("#Not", func() {
+		It("should act only on the elements that do not satisfy the predicate", func() {
+			filtered := selection.Not(func(index int, s *Selection) bool {
+				return index == 1
+			})
+			Expect(filtered.Click()).To(Succeed())
+			Expect(elements[0].ClickCall.Called).To(BeTrue())
+			Expect(elements[1].ClickCall.Called).To(BeFalse())
+			Expect(elements[2].ClickCall.Called).To(BeTrue())
+		})
+	})
+
+	Describe("#Map", func() {
+		It("should return the result of fn for every element in order", func() {
+			elements[0].GetAttributeCall.ReturnValue = "a"
+			elements[1].GetAttributeCall.ReturnValue = "b"
+			elements[2].GetAttributeCall.ReturnValue = "c"
+
+			results := selection.Map(func(index int, s *Selection) string {
+				value, _ := s.GetAttribute("data-id")
+				return value
+			})
+			Expect(results).To(Equal([]string{"a", "b", "c"}))
+		})
+	})
+
+	Describe("#Each", func() {
+		It("should call fn for every element in order", func() {
+			var seen []int
+			err := selection.Each(func(index int, s *Selection) error {
+				seen = append(seen, index)
+				return nil
+			})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(seen).To(Equal([]int{0, 1, 2}))
+		})
+
+		Context("when fn returns an error", func() {
+			It("should stop and return it", func() {
+				called := 0
+				err := selection.Each(func(index int, s *Selection) error {
+					called++
+					if index == 1 {
+						return errors.New("some error")
+					}
+					return nil
+				})
+				Expect(err).To(MatchError("some error"))
+				Expect(called).To(Equal(2))
+			})
+		})
+	})
+
+	Describe("#End", func() {
+		It("should roll back to the parent selection", func() {
+			Expect(selection.First().End()).To(Equal(selection))
+		})
+
+		It("should no-op when there is no parent", func() {
+			Expect(selection.End()).To(Equal(selection))
+		})
+	})
+})
@@ -0,0 +1,76 @@
+package agouti_test
+
+import (
+	"os"
+	"path/filepath"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/sclevine/agouti"
+	"github.com/sclevine/agouti/internal/element"
+	"github.com/sclevine/agouti/internal/mocks"
+)
+
+var _ = Describe("MultiSelection file uploads", func() {
+	var (
+		selection    *MultiSelection
+		firstElement *mocks.Element
+	)
+
+	BeforeEach(func() {
+		firstElement = &mocks.Element{}
+		firstElement.GetNameCall.ReturnName = "input"
+		firstElement.GetAttributeCall.ReturnValues = map[string]string{"type": "file", "multiple": "true"}
+
+		elementRepository := &mocks.ElementRepository{}
+		elementRepository.GetAtLeastOneCall.ReturnElements = []element.Element{firstElement}
+		selection = NewTestMultiSelection(&mocks.Session{}, elementRepository, "#selector")
+	})
+
+	Describe("#UploadFile with multiple paths", func() {
+		It("should enter a newline-joined list of absolute paths", func() {
+			Expect(selection.UploadFile("some-file", "other-file")).To(Succeed())
+			firstAbs, _ := filepath.Abs("some-file")
+			secondAbs, _ := filepath.Abs("other-file")
+			Expect(firstElement.ValueCall.Text).To(Equal(firstAbs + "\n" + secondAbs))
+		})
+
+		Context("when the element has no 'multiple' attribute", func() {
+			It("should return an error", func() {
+				firstElement.GetAttributeCall.ReturnValues["multiple"] = ""
+				err := selection.UploadFile("some-file", "other-file")
+				Expect(err).To(MatchError("element for selection 'CSS: #selector' does not accept multiple files"))
+			})
+		})
+	})
+
+	Describe("#UploadFiles", func() {
+		It("should behave the same as UploadFile", func() {
+			Expect(selection.UploadFiles("some-file", "other-file")).To(Succeed())
+			Expect(firstElement.ValueCall.Text).To(ContainSubstring("some-file"))
+		})
+	})
+
+	Describe("#UploadDirectory", func() {
+		var dir string
+
+		BeforeEach(func() {
+			var err error
+			dir, err = os.MkdirTemp("", "agouti-upload-test")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(os.WriteFile(filepath.Join(dir, "a.txt"), []byte("a"), 0644)).To(Succeed())
+			Expect(os.Mkdir(filepath.Join(dir, "sub"), 0755)).To(Succeed())
+			Expect(os.WriteFile(filepath.Join(dir, "sub", "b.txt"), []byte("b"), 0644)).To(Succeed())
+		})
+
+		AfterEach(func() {
+			Expect(os.RemoveAll(dir)).To(Succeed())
+		})
+
+		It("should upload every regular file found beneath the directory", func() {
+			Expect(selection.UploadDirectory(dir)).To(Succeed())
+			Expect(firstElement.ValueCall.Text).To(ContainSubstring(filepath.Join(dir, "a.txt")))
+			Expect(firstElement.ValueCall.Text).To(ContainSubstring(filepath.Join(dir, "sub", "b.txt")))
+		})
+	})
+})
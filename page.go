@@ -0,0 +1,80 @@
+package agouti
+
+import (
+	"errors"
+	"time"
+
+	"github.com/sclevine/agouti/api"
+	"github.com/sclevine/agouti/internal/element"
+)
+
+// Page represents an open browser session.
+type Page struct {
+	session session
+
+	actionTimeout  time.Duration
+	actionInterval time.Duration
+	parallelism    int
+}
+
+// SetActionTimeout sets the default timeout and retry interval (see
+// MultiSelection.WithTimeout) that selections created from this page via
+// Find use for their actions. A zero timeout, the default, disables
+// retrying; a selection can still opt into its own timeout by calling
+// WithTimeout directly.
+func (p *Page) SetActionTimeout(timeout, interval time.Duration) {
+	p.actionTimeout = timeout
+	p.actionInterval = interval
+}
+
+// SetParallelActions sets the default parallelism (see
+// MultiSelection.Parallel) that selections created from this page via
+// Find use for their actions. n <= 1, the default, disables parallelism
+// so actions act on one element at a time; a selection can still opt into
+// its own parallelism by calling Parallel directly.
+func (p *Page) SetParallelActions(n int) {
+	p.parallelism = n
+}
+
+// Find returns a selection matching the elements currently on the page
+// identified by the given CSS selector, seeded with this page's default
+// action timeout and interval. Unlike a selection built directly from its
+// collaborators, Find's selection re-queries the session for matching
+// elements every time an action runs, so it reflects the current state of
+// the page rather than a snapshot taken when Find was called.
+func (p *Page) Find(selector string) *Selection {
+	return &Selection{&MultiSelection{
+		selectorString: "CSS: " + selector,
+		session:        p.session,
+		elements: &sessionRepository{
+			session:  p.session,
+			selector: api.Selector{Using: "css selector", Value: selector},
+		},
+		timeout:     p.actionTimeout,
+		interval:    p.actionInterval,
+		parallelism: p.parallelism,
+	}}
+}
+
+// sessionRepository is the element.Repository behind a selection built by
+// Page.Find.
+type sessionRepository struct {
+	session  session
+	selector api.Selector
+}
+
+func (r *sessionRepository) GetAtLeastOne() ([]element.Element, error) {
+	found, err := r.session.GetElements(r.selector)
+	if err != nil {
+		return nil, err
+	}
+	if len(found) == 0 {
+		return nil, errors.New("no elements found")
+	}
+
+	elements := make([]element.Element, len(found))
+	for i, foundElement := range found {
+		elements[i] = foundElement
+	}
+	return elements, nil
+}
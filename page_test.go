@@ -0,0 +1,76 @@
+package agouti_test
+
+import (
+	"errors"
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/sclevine/agouti"
+	"github.com/sclevine/agouti/api"
+	"github.com/sclevine/agouti/internal/mocks"
+)
+
+var _ = Describe("Page", func() {
+	var (
+		page    *Page
+		session *mocks.Session
+	)
+
+	BeforeEach(func() {
+		session = &mocks.Session{}
+		page = NewTestPage(session)
+	})
+
+	Describe("#Find", func() {
+		It("should return a selection that locates elements using a CSS selector", func() {
+			session.GetElementsCall.ReturnElements = []*api.Element{{ID: "some-id", Session: &api.Session{Bus: &mocks.Bus{}}}}
+			Expect(page.Find("#selector").Click()).To(Succeed())
+			Expect(session.GetElementsCall.Selector).To(Equal(api.Selector{Using: "css selector", Value: "#selector"}))
+		})
+
+		Context("when no elements are found", func() {
+			It("should return an error", func() {
+				_, err := page.Find("#selector").GetName()
+				Expect(err).To(MatchError("failed to select elements from selection 'CSS: #selector': no elements found"))
+			})
+		})
+
+		Context("when locating elements fails", func() {
+			It("should return an error", func() {
+				session.GetElementsCall.Err = errors.New("some error")
+				_, err := page.Find("#selector").GetName()
+				Expect(err).To(MatchError("failed to select elements from selection 'CSS: #selector': some error"))
+			})
+		})
+	})
+
+	Describe("#SetActionTimeout", func() {
+		It("should be used as the default timeout for selections the page finds", func() {
+			page.SetActionTimeout(20*time.Millisecond, 5*time.Millisecond)
+			session.GetElementsCall.Err = errors.New("some error")
+
+			start := time.Now()
+			err := page.Find("#selector").Click()
+			Expect(time.Since(start)).To(BeNumerically(">=", 20*time.Millisecond))
+			Expect(err).To(MatchError("failed to select elements from selection 'CSS: #selector': some error after waiting 20ms"))
+		})
+	})
+
+	Describe("#SetParallelActions", func() {
+		It("should be used as the default parallelism for selections the page finds", func() {
+			page.SetParallelActions(2)
+
+			firstBus := &mocks.Bus{}
+			secondBus := &mocks.Bus{}
+			secondBus.SendCall.Err = errors.New("some error")
+			session.GetElementsCall.ReturnElements = []*api.Element{
+				{ID: "one", Session: &api.Session{Bus: firstBus}},
+				{ID: "two", Session: &api.Session{Bus: secondBus}},
+			}
+
+			err := page.Find("#selector").Click()
+			Expect(err).To(MatchError("failed to click on selection 'CSS: #selector': [element 1: some error]"))
+		})
+	})
+})
@@ -0,0 +1,11 @@
+package agouti
+
+//go:generate go run ./internal/elementgen -input=internal/elementgen/tags.yaml -output=element_gen.go
+
+// Selection wraps a MultiSelection. The actions it inherits from
+// MultiSelection (Click, Fill, ...) act on every matched element; its own
+// accessors (GetAttribute, GetName, and the typed wrappers' getters) read
+// the first matched element.
+type Selection struct {
+	*MultiSelection
+}
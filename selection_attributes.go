@@ -0,0 +1,35 @@
+package agouti
+
+import "fmt"
+
+// GetAttribute returns the value of the named attribute of the first
+// element matched by the selection. It is the building block typed
+// wrappers such as InputSelection use for their typed accessors; see
+// element_gen.go.
+func (s *Selection) GetAttribute(attribute string) (string, error) {
+	elements, err := s.elements.GetAtLeastOne()
+	if err != nil {
+		return "", fmt.Errorf("failed to select elements from selection '%s': %s", s, err)
+	}
+	return elements[0].GetAttribute(attribute)
+}
+
+// GetName returns the tag name of the first element matched by the
+// selection.
+func (s *Selection) GetName() (string, error) {
+	elements, err := s.elements.GetAtLeastOne()
+	if err != nil {
+		return "", fmt.Errorf("failed to select elements from selection '%s': %s", s, err)
+	}
+	return elements[0].GetName()
+}
+
+// AsElement narrows the selection to whichever typed wrapper (e.g.
+// InputSelection, LinkSelection) matches the tag name, and where tags.yaml
+// calls for it, an attribute, of the first element matched by the
+// selection. It returns the selection unchanged, as a *Selection, if
+// nothing in tags.yaml matches; callers that already know the element's
+// type should call the As* method directly instead.
+func (s *Selection) AsElement() (interface{}, error) {
+	return selToElement(s)
+}
@@ -0,0 +1,82 @@
+package agouti
+
+import (
+	"errors"
+	"fmt"
+	"time"
+)
+
+// defaultRetryInterval is used when a selection has a timeout but no
+// explicit retry interval.
+const defaultRetryInterval = 50 * time.Millisecond
+
+// permanentError marks an error that retry should not retry: a validation
+// failure (e.g. the wrong element type) that cannot succeed no matter how
+// many times the action is attempted, as opposed to a transient failure
+// (element repository errors, element-not-found, not-interactable,
+// stale-reference) that a later attempt might clear.
+type permanentError struct {
+	err error
+}
+
+// permanentf builds a permanentError the same way fmt.Errorf builds a
+// plain one.
+func permanentf(format string, args ...interface{}) error {
+	return &permanentError{err: fmt.Errorf(format, args...)}
+}
+
+func (e *permanentError) Error() string {
+	return e.err.Error()
+}
+
+func (e *permanentError) Unwrap() error {
+	return e.err
+}
+
+// WithTimeout returns a copy of the selection that retries its actions
+// (Click, Fill, Check, Select, Submit, UploadFile, DoubleClick) on the
+// given interval until they succeed or the timeout elapses, instead of
+// failing on the first transient error. A zero timeout disables retrying,
+// which is also the default.
+func (m *MultiSelection) WithTimeout(timeout, interval time.Duration) *MultiSelection {
+	selectionCopy := *m
+	selectionCopy.timeout = timeout
+	selectionCopy.interval = interval
+	return &selectionCopy
+}
+
+// retry runs action once if no timeout is set, or repeatedly on m.interval
+// until it succeeds, it fails with a permanent (non-retryable) error, or
+// m.timeout elapses. The last error is returned, annotated with how long
+// retrying was attempted, unless it's permanent, in which case it's
+// returned as-is immediately.
+func (m *MultiSelection) retry(action func() error) error {
+	if m.timeout <= 0 {
+		return action()
+	}
+
+	interval := m.interval
+	if interval <= 0 {
+		interval = defaultRetryInterval
+	}
+
+	deadline := time.Now().Add(m.timeout)
+
+	for {
+		err := action()
+		if err == nil {
+			return nil
+		}
+
+		var permanent *permanentError
+		if errors.As(err, &permanent) {
+			return err
+		}
+
+		if time.Now().After(deadline) {
+			return fmt.Errorf("%s after waiting %s", err, m.timeout)
+		}
+
+		time.Sleep(interval)
+	}
+}
@@ -0,0 +1,69 @@
+package agouti_test
+
+import (
+	"errors"
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/sclevine/agouti"
+	"github.com/sclevine/agouti/internal/element"
+	"github.com/sclevine/agouti/internal/mocks"
+)
+
+var _ = Describe("Selection action timeouts", func() {
+	var (
+		selection         *MultiSelection
+		session           *mocks.Session
+		elementRepository *mocks.ElementRepository
+		firstElement      *mocks.Element
+	)
+
+	BeforeEach(func() {
+		session = &mocks.Session{}
+		firstElement = &mocks.Element{}
+		elementRepository = &mocks.ElementRepository{}
+		selection = NewTestMultiSelection(session, elementRepository, "#selector")
+		elementRepository.GetAtLeastOneCall.ReturnElements = []element.Element{firstElement}
+	})
+
+	Context("when no timeout is set", func() {
+		It("should fail immediately without retrying", func() {
+			elementRepository.GetAtLeastOneCall.Err = errors.New("some error")
+			Expect(selection.Click()).To(MatchError("failed to select elements from selection 'CSS: #selector': some error"))
+		})
+	})
+
+	Context("when a timeout is set", func() {
+		It("should retry until the action succeeds", func() {
+			elementRepository.GetAtLeastOneCall.Err = errors.New("some error")
+
+			go func() {
+				time.Sleep(20 * time.Millisecond)
+				elementRepository.SetErr(nil)
+			}()
+
+			timedSelection := selection.WithTimeout(200*time.Millisecond, 5*time.Millisecond)
+			Expect(timedSelection.Click()).To(Succeed())
+			Expect(firstElement.ClickCall.Called).To(BeTrue())
+		})
+
+		It("should return the last error, annotated with how long it waited, once the timeout elapses", func() {
+			elementRepository.GetAtLeastOneCall.Err = errors.New("some error")
+
+			timedSelection := selection.WithTimeout(20*time.Millisecond, 5*time.Millisecond)
+			err := timedSelection.Click()
+			Expect(err).To(MatchError("failed to select elements from selection 'CSS: #selector': some error after waiting 20ms"))
+		})
+
+		It("should fail immediately on a permanent (validation) error, without retrying or waiting out the timeout", func() {
+			firstElement.GetAttributeCall.ReturnValue = "text"
+
+			timedSelection := selection.WithTimeout(200*time.Millisecond, 5*time.Millisecond)
+			start := time.Now()
+			err := timedSelection.Check()
+			Expect(time.Since(start)).To(BeNumerically("<", 100*time.Millisecond))
+			Expect(err).To(MatchError("selection 'CSS: #selector' does not refer to a checkbox"))
+		})
+	})
+})